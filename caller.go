@@ -0,0 +1,94 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// loggerPackageName is this package's import path, captured once at init so
+// getCaller can skip over its own frames.
+var loggerPackageName = func() string {
+	pc, _, _, ok := runtime.Caller(0)
+	if !ok {
+		return ""
+	}
+	f := runtime.FuncForPC(pc)
+	if f == nil {
+		return ""
+	}
+	return getPackageName(f.Name())
+}()
+
+const logrusPackageName = "github.com/sirupsen/logrus"
+
+// getPackageName strips the function/method name off a fully qualified
+// runtime.Frame.Function value, leaving the package import path.
+func getPackageName(funcName string) string {
+	for {
+		lastPeriod := strings.LastIndex(funcName, ".")
+		lastSlash := strings.LastIndexByte(funcName, '/')
+		if lastPeriod > lastSlash {
+			funcName = funcName[:lastPeriod]
+		} else {
+			break
+		}
+	}
+	return funcName
+}
+
+// getCaller walks the goroutine stack to find the first frame outside of
+// logrus and this package, i.e. the actual call site that logged the entry.
+func getCaller() *runtime.Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		pkg := getPackageName(frame.Function)
+		if pkg != logrusPackageName && pkg != loggerPackageName {
+			return &frame
+		}
+		if !more {
+			break
+		}
+	}
+	return nil
+}
+
+// SetReportCaller enables file:line/function enrichment via the <caller>
+// and <func> MsgFormat tokens and the "caller"/"func" JSON fields.
+func (f *customFormatter) SetReportCaller(enabled bool) *customFormatter {
+	f.reportCaller = enabled
+	return f
+}
+
+// SetCallerTrimPrefix trims any of the given path prefixes (e.g. this
+// module's import path) off caller/func output for readability.
+func (f *customFormatter) SetCallerTrimPrefix(prefixes []string) *customFormatter {
+	f.callerTrimPrefix = prefixes
+	return f
+}
+
+func (f *customFormatter) trimCallerPrefix(s string) string {
+	for _, prefix := range f.callerTrimPrefix {
+		if strings.HasPrefix(s, prefix) {
+			return strings.TrimPrefix(s, prefix)
+		}
+	}
+	return s
+}
+
+// callerInfo returns the trimmed "file:line" and function name of the
+// caller that logged the current entry, or two empty strings if the call
+// site couldn't be resolved.
+func (f *customFormatter) callerInfo() (caller string, funcName string) {
+	frame := getCaller()
+	if frame == nil {
+		return "", ""
+	}
+	caller = f.trimCallerPrefix(fmt.Sprintf("%s:%d", frame.File, frame.Line))
+	funcName = f.trimCallerPrefix(frame.Function)
+	return caller, funcName
+}
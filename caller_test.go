@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetPackageNameStripsFuncAndMethod(t *testing.T) {
+	cases := map[string]string{
+		"github.com/jialijelly/go-custom-logger.getCaller":                 "github.com/jialijelly/go-custom-logger",
+		"github.com/jialijelly/go-custom-logger.(*customFormatter).Format": "github.com/jialijelly/go-custom-logger",
+		"github.com/sirupsen/logrus.(*Entry).log":                          "github.com/sirupsen/logrus",
+	}
+	for funcName, want := range cases {
+		if got := getPackageName(funcName); got != want {
+			t.Errorf("getPackageName(%q) = %q, want %q", funcName, got, want)
+		}
+	}
+}
+
+// TestCallerInfoSkipsPackageAndLogrusFrames exercises getCaller's documented
+// contract: walk past any frame belonging to this package or to logrus and
+// report the first one that doesn't. Called directly from a same-package
+// test, the nearest such frame is testing's own call into the test function.
+func TestCallerInfoSkipsPackageAndLogrusFrames(t *testing.T) {
+	f := NewFormatter().SetReportCaller(true)
+	caller, funcName := f.callerInfo()
+
+	if caller == "" || funcName == "" {
+		t.Fatalf("callerInfo() = (%q, %q), want both non-empty", caller, funcName)
+	}
+	if strings.Contains(funcName, loggerPackageName) {
+		t.Fatalf("funcName = %q, want a frame outside %s", funcName, loggerPackageName)
+	}
+	if strings.Contains(funcName, logrusPackageName) {
+		t.Fatalf("funcName = %q, want a frame outside %s", funcName, logrusPackageName)
+	}
+}
+
+func TestCallerInfoTrimsPrefix(t *testing.T) {
+	plain := NewFormatter().SetReportCaller(true)
+	rawCaller, rawFunc := plain.callerInfo()
+
+	funcPrefix := rawFunc[:strings.LastIndex(rawFunc, ".")+1]
+	callerPrefix := rawCaller[:strings.Index(rawCaller, ":")+1]
+
+	trimmed := NewFormatter().SetReportCaller(true).SetCallerTrimPrefix([]string{funcPrefix, callerPrefix})
+	caller, funcName := trimmed.callerInfo()
+
+	if funcName != strings.TrimPrefix(rawFunc, funcPrefix) {
+		t.Fatalf("funcName = %q, want %q with prefix %q trimmed", funcName, strings.TrimPrefix(rawFunc, funcPrefix), funcPrefix)
+	}
+	if caller != strings.TrimPrefix(rawCaller, callerPrefix) {
+		t.Fatalf("caller = %q, want %q with prefix %q trimmed", caller, strings.TrimPrefix(rawCaller, callerPrefix), callerPrefix)
+	}
+}
+
+func TestCallerInfoNoMatchingPrefixLeavesUnchanged(t *testing.T) {
+	rawCaller, _ := NewFormatter().SetReportCaller(true).callerInfo()
+	f := NewFormatter().SetReportCaller(true).SetCallerTrimPrefix([]string{"/does/not/match"})
+	caller, _ := f.callerInfo()
+
+	if caller != rawCaller {
+		t.Fatalf("caller = %q, want untrimmed %q", caller, rawCaller)
+	}
+	if strings.HasPrefix(caller, "/does/not/match") {
+		t.Fatalf("caller = %q, unexpected prefix match", caller)
+	}
+	if caller == "" {
+		t.Fatal("caller should still be reported when no trim prefix matches")
+	}
+}
+
+func TestJsonFormatIncludesCallerWhenEnabled(t *testing.T) {
+	f := DefaultFormatter("").SetJsonOutput().SetReportCaller(true)
+	decoded := decodeJSON(t, formatOrFatal(t, f, newTestEntry("hello", nil)))
+
+	caller, ok := decoded[FieldKeyCaller].(string)
+	if !ok || caller == "" {
+		t.Fatalf("caller field = %#v, want non-empty string", decoded[FieldKeyCaller])
+	}
+	if funcName, ok := decoded[FieldKeyFunc].(string); !ok || funcName == "" {
+		t.Fatalf("func field = %#v, want non-empty string", decoded[FieldKeyFunc])
+	}
+}
@@ -0,0 +1,38 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+type entryContextKey struct{}
+
+// Entry wraps a *logrus.Entry with a Step helper for logging progress
+// checkpoints (PrefixRequestHandling) while handling a single request.
+type Entry struct {
+	*logrus.Entry
+}
+
+// Step logs msg prefixed with PrefixRequestHandling, for custom checkpoints
+// within request handling (e.g. "validated input", "wrote to db").
+func (e *Entry) Step(msg string) {
+	e.Info(fmt.Sprintf("%s %s", PrefixRequestHandling, msg))
+}
+
+// NewContext returns a context carrying entry, retrievable via FromContext.
+// Middleware uses this to thread a request-scoped *logrus.Entry (already
+// tagged with RequestIdKey) down to handler code.
+func NewContext(ctx context.Context, entry *logrus.Entry) context.Context {
+	return context.WithValue(ctx, entryContextKey{}, &Entry{entry})
+}
+
+// FromContext returns the *Entry stored by NewContext, or an *Entry wrapping
+// logrus.StandardLogger() if ctx carries none.
+func FromContext(ctx context.Context) *Entry {
+	if entry, ok := ctx.Value(entryContextKey{}).(*Entry); ok {
+		return entry
+	}
+	return &Entry{logrus.NewEntry(logrus.StandardLogger())}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -19,10 +20,23 @@ const (
 	defaultTimeFormat    = time.RFC3339
 	defaultDataSeparator = " |"
 
-	logTimeKey  = "<time>"
-	logLevelKey = "<level>"
-	logIdKey    = "<id>"
-	logMsgKey   = "<msg>"
+	logTimeKey   = "<time>"
+	logLevelKey  = "<level>"
+	logIdKey     = "<id>"
+	logMsgKey    = "<msg>"
+	logCallerKey = "<caller>"
+	logFuncKey   = "<func>"
+
+	// FieldKeyTime, FieldKeyLevel, FieldKeyID, FieldKeyMsg, FieldKeyData,
+	// FieldKeyCaller and FieldKeyFunc are the default JSON field names used
+	// by jsonFormat. They can be renamed via customFormatter.SetJsonFieldMap.
+	FieldKeyTime   = "timestamp"
+	FieldKeyLevel  = "level"
+	FieldKeyID     = "id"
+	FieldKeyMsg    = "message"
+	FieldKeyData   = "data"
+	FieldKeyCaller = "caller"
+	FieldKeyFunc   = "func"
 )
 
 var (
@@ -33,10 +47,35 @@ var (
 	}
 )
 
+// FieldMap lets users rename the default JSON field names (FieldKeyTime,
+// FieldKeyLevel, FieldKeyID, FieldKeyMsg, FieldKeyData) produced by
+// jsonFormat, e.g. to "@timestamp" for Elasticsearch/Logstash. Keys that are
+// not present in the map keep their default name.
+type FieldMap map[string]string
+
+func (f FieldMap) resolve(key string) string {
+	if renamed, exist := f[key]; exist {
+		return renamed
+	}
+	return key
+}
+
 type customFormatter struct {
 	isDefault         bool
 	jsonOutput        bool
+	terminalOutput    bool
+	logstashOutput    bool
+	ecsOutput         bool
 	textDataSeparator string
+	jsonFieldMap      FieldMap
+	jsonDataKey       string
+	logstashType      string
+	ecsServiceName    string
+	reportCaller      bool
+	callerTrimPrefix  []string
+	colorOutput       *bool
+	fieldWidths       map[string]int
+	fieldWidthsMu     sync.Mutex
 	MsgPrefix         string
 	MsgFormat         string
 	TimeFormat        string
@@ -87,6 +126,22 @@ func (f *customFormatter) SetJsonOutput() *customFormatter {
 	return f
 }
 
+// SetJsonFieldMap renames the default JSON field names (see FieldKeyTime,
+// FieldKeyLevel, FieldKeyID, FieldKeyMsg, FieldKeyData) in jsonFormat output,
+// e.g. mapping "timestamp" to "@timestamp" for Elasticsearch/Logstash.
+func (f *customFormatter) SetJsonFieldMap(fieldMap map[string]string) *customFormatter {
+	f.jsonFieldMap = FieldMap(fieldMap)
+	return f
+}
+
+// SetJsonDataKey nests all entry.Data fields under the given key in
+// jsonFormat output instead of spreading them under FieldKeyData. Pass ""
+// to restore the default behavior.
+func (f *customFormatter) SetJsonDataKey(key string) *customFormatter {
+	f.jsonDataKey = key
+	return f
+}
+
 // SetTimeFormat defined the output time format.
 func (f *customFormatter) SetTimeFormat(format string) *customFormatter {
 	f.TimeFormat = format
@@ -100,6 +155,15 @@ func (f *customFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 	if f.jsonOutput {
 		return f.jsonFormat(entry)
 	}
+	if f.logstashOutput {
+		return f.logstashFormat(entry)
+	}
+	if f.ecsOutput {
+		return f.ecsFormat(entry)
+	}
+	if f.terminalOutput {
+		return f.terminalFormat(entry)
+	}
 	return f.textFormat(entry)
 }
 
@@ -114,6 +178,12 @@ func (f *customFormatter) getMessage(entry *logrus.Entry) string {
 		output = strings.Replace(output, fmt.Sprintf(" [%v]", logIdKey), "", 1)
 	}
 
+	if f.reportCaller {
+		caller, funcName := f.callerInfo()
+		output = strings.Replace(output, logCallerKey, caller, 1)
+		output = strings.Replace(output, logFuncKey, funcName, 1)
+	}
+
 	// To support other custom formats using data keys to design log output.
 	for k, val := range entry.Data {
 		if k == RequestIdKey {
@@ -135,28 +205,41 @@ func (f *customFormatter) getMessage(entry *logrus.Entry) string {
 	return output
 }
 
-// TODO: Customizable json structure.
-type jsonOutputFormat struct {
-	Timestamp string        `json:"timestamp"`
-	Level     string        `json:"level"`
-	ID        *string       `json:"id,omitempty"`
-	Message   string        `json:"message"`
-	Data      logrus.Fields `json:"data,omitempty"`
-}
-
 func (f *customFormatter) jsonFormat(entry *logrus.Entry) ([]byte, error) {
-	format := jsonOutputFormat{
-		Timestamp: entry.Time.Format(defaultTimeFormat),
-		Level:     strings.ToUpper(entry.Level.String()),
-		Message:   f.getMessage(entry),
-		Data:      entry.Data,
-	}
+	fieldMap := f.jsonFieldMap
+
+	data := make(logrus.Fields, 5)
+	data[fieldMap.resolve(FieldKeyTime)] = entry.Time.Format(defaultTimeFormat)
+	data[fieldMap.resolve(FieldKeyLevel)] = strings.ToUpper(entry.Level.String())
+	data[fieldMap.resolve(FieldKeyMsg)] = f.getMessage(entry)
 	if val, exist := entry.Data[RequestIdKey]; exist {
-		id := val.(string)
-		format.ID = &id
+		if id, ok := val.(string); ok {
+			data[fieldMap.resolve(FieldKeyID)] = id
+		}
+	}
+	if f.reportCaller {
+		caller, funcName := f.callerInfo()
+		data[fieldMap.resolve(FieldKeyCaller)] = caller
+		data[fieldMap.resolve(FieldKeyFunc)] = funcName
+	}
+
+	if len(entry.Data) > 0 {
+		dataKey := f.jsonDataKey
+		if dataKey == "" {
+			dataKey = fieldMap.resolve(FieldKeyData)
+		}
+		nested := make(logrus.Fields, len(entry.Data))
+		for k, val := range entry.Data {
+			nested[k] = val
+		}
+		data[dataKey] = nested
+	}
+
+	output, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
 	}
-	output, err := json.Marshal(&format)
-	return []byte(fmt.Sprintln(output)), err
+	return append(output, '\n'), nil
 }
 
 func (f *customFormatter) textFormat(entry *logrus.Entry) ([]byte, error) {
@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func newTestEntry(msg string, data logrus.Fields) *logrus.Entry {
+	entry := logrus.NewEntry(logrus.New())
+	entry.Time = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	entry.Level = logrus.InfoLevel
+	entry.Message = msg
+	entry.Data = data
+	return entry
+}
+
+func decodeJSON(t *testing.T, out []byte) map[string]interface{} {
+	t.Helper()
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(%q): %v", out, err)
+	}
+	return decoded
+}
+
+func TestJsonFormatDefaultFieldNames(t *testing.T) {
+	f := DefaultFormatter("").SetJsonOutput()
+	decoded := decodeJSON(t, formatOrFatal(t, f, newTestEntry("hello", logrus.Fields{"foo": "bar"})))
+
+	if decoded[FieldKeyLevel] != "INFO" {
+		t.Fatalf("level = %v, want INFO", decoded[FieldKeyLevel])
+	}
+	data, ok := decoded[FieldKeyData].(map[string]interface{})
+	if !ok {
+		t.Fatalf("data field missing or wrong type: %#v", decoded)
+	}
+	if data["foo"] != "bar" {
+		t.Fatalf("data.foo = %v, want bar", data["foo"])
+	}
+}
+
+func TestJsonFormatFieldMapRenamesKeys(t *testing.T) {
+	f := DefaultFormatter("").SetJsonOutput().SetJsonFieldMap(map[string]string{
+		FieldKeyTime:  "@timestamp",
+		FieldKeyLevel: "log.level",
+	})
+	decoded := decodeJSON(t, formatOrFatal(t, f, newTestEntry("hello", nil)))
+
+	if _, exist := decoded["@timestamp"]; !exist {
+		t.Fatalf("expected renamed @timestamp field, got %#v", decoded)
+	}
+	if _, exist := decoded["log.level"]; !exist {
+		t.Fatalf("expected renamed log.level field, got %#v", decoded)
+	}
+	if _, exist := decoded[FieldKeyTime]; exist {
+		t.Fatalf("default timestamp field should be absent, got %#v", decoded)
+	}
+	if _, exist := decoded[FieldKeyLevel]; exist {
+		t.Fatalf("default level field should be absent, got %#v", decoded)
+	}
+}
+
+func TestJsonFormatDataKeyNestsFields(t *testing.T) {
+	f := DefaultFormatter("").SetJsonOutput().SetJsonDataKey("fields")
+	decoded := decodeJSON(t, formatOrFatal(t, f, newTestEntry("hello", logrus.Fields{"foo": "bar"})))
+
+	fields, ok := decoded["fields"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("fields key missing or wrong type: %#v", decoded)
+	}
+	if fields["foo"] != "bar" {
+		t.Fatalf("fields.foo = %v, want bar", fields["foo"])
+	}
+	if _, exist := decoded[FieldKeyData]; exist {
+		t.Fatalf("default data field should be absent when SetJsonDataKey is used, got %#v", decoded)
+	}
+}
+
+func TestJsonFormatPromotesRequestID(t *testing.T) {
+	f := DefaultFormatter("").SetJsonOutput()
+	decoded := decodeJSON(t, formatOrFatal(t, f, newTestEntry("hello", logrus.Fields{RequestIdKey: "req-1"})))
+
+	if decoded[FieldKeyID] != "req-1" {
+		t.Fatalf("id = %v, want req-1", decoded[FieldKeyID])
+	}
+}
+
+func formatOrFatal(t *testing.T, f *customFormatter, entry *logrus.Entry) []byte {
+	t.Helper()
+	out, err := f.Format(entry)
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	return out
+}
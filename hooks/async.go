@@ -0,0 +1,136 @@
+package hooks
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// OverflowPolicy controls what AsyncHook does when its buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock blocks Fire until the buffer has room.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDrop drops the entry and increments Dropped instead of
+	// blocking the logging goroutine.
+	OverflowDrop
+)
+
+// AsyncHook wraps another logrus.Hook so Fire never blocks the logging
+// goroutine on slow sinks (file, network). Entries are buffered and handed
+// to inner.Fire from a background goroutine every flushInterval.
+//
+// Close is safe to call concurrently with Fire: once closed, Fire becomes a
+// no-op instead of racing to send on a closed channel.
+type AsyncHook struct {
+	inner   logrus.Hook
+	policy  OverflowPolicy
+	entries chan *logrus.Entry
+	dropped uint64
+
+	closed    int32
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewAsyncHook buffers up to bufSize entries for inner, flushing them every
+// flushInterval. policy decides what happens when the buffer is full.
+func NewAsyncHook(inner logrus.Hook, bufSize int, flushInterval time.Duration, policy OverflowPolicy) *AsyncHook {
+	h := &AsyncHook{
+		inner:   inner,
+		policy:  policy,
+		entries: make(chan *logrus.Entry, bufSize),
+		done:    make(chan struct{}),
+	}
+	go h.loop(flushInterval)
+	return h
+}
+
+func (h *AsyncHook) Levels() []logrus.Level {
+	return h.inner.Levels()
+}
+
+// Fire buffers entry for the background flush loop. It is a no-op once
+// Close has been called, and never sends on a channel that Close has
+// closed.
+func (h *AsyncHook) Fire(entry *logrus.Entry) error {
+	if atomic.LoadInt32(&h.closed) == 1 {
+		return nil
+	}
+
+	clone := entry.Dup()
+
+	if h.policy == OverflowDrop {
+		select {
+		case h.entries <- clone:
+		case <-h.done:
+		default:
+			atomic.AddUint64(&h.dropped, 1)
+		}
+		return nil
+	}
+
+	select {
+	case h.entries <- clone:
+	case <-h.done:
+	}
+	return nil
+}
+
+func (h *AsyncHook) loop(flushInterval time.Duration) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var pending []*logrus.Entry
+	for {
+		select {
+		case entry := <-h.entries:
+			pending = append(pending, entry)
+		case <-ticker.C:
+			h.drain(pending)
+			pending = nil
+		case <-h.done:
+			h.drain(pending)
+			h.drainBuffered()
+			return
+		}
+	}
+}
+
+func (h *AsyncHook) drain(entries []*logrus.Entry) {
+	for _, entry := range entries {
+		_ = h.inner.Fire(entry)
+	}
+}
+
+// drainBuffered flushes any entries left sitting in the channel buffer at
+// shutdown, without blocking for more to arrive.
+func (h *AsyncHook) drainBuffered() {
+	for {
+		select {
+		case entry := <-h.entries:
+			_ = h.inner.Fire(entry)
+		default:
+			return
+		}
+	}
+}
+
+// Dropped returns the number of entries dropped due to a full buffer under
+// OverflowDrop.
+func (h *AsyncHook) Dropped() uint64 {
+	return atomic.LoadUint64(&h.dropped)
+}
+
+// Close stops the flush loop, draining any buffered entries to inner first.
+// It is safe to call concurrently with Fire and is idempotent.
+func (h *AsyncHook) Close() error {
+	h.closeOnce.Do(func() {
+		atomic.StoreInt32(&h.closed, 1)
+		close(h.done)
+	})
+	return nil
+}
@@ -0,0 +1,121 @@
+package hooks
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type fakeHook struct {
+	mu    sync.Mutex
+	fired []*logrus.Entry
+}
+
+func (h *fakeHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *fakeHook) Fire(entry *logrus.Entry) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fired = append(h.fired, entry)
+	return nil
+}
+
+func (h *fakeHook) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.fired)
+}
+
+func TestAsyncHookFlushesToInner(t *testing.T) {
+	inner := &fakeHook{}
+	h := NewAsyncHook(inner, 10, 10*time.Millisecond, OverflowBlock)
+	defer h.Close()
+
+	for i := 0; i < 3; i++ {
+		if err := h.Fire(logrus.NewEntry(logrus.New())); err != nil {
+			t.Fatalf("Fire returned error: %v", err)
+		}
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if inner.count() == 3 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("inner hook fired %d entries, want 3", inner.count())
+}
+
+func TestAsyncHookDropsOnFullBuffer(t *testing.T) {
+	inner := &fakeHook{}
+	// Construct directly (not via NewAsyncHook) so the flush loop isn't
+	// running to drain the buffer concurrently, making the overflow
+	// deterministic.
+	h := &AsyncHook{
+		inner:   inner,
+		policy:  OverflowDrop,
+		entries: make(chan *logrus.Entry, 1),
+	}
+
+	if err := h.Fire(logrus.NewEntry(logrus.New())); err != nil {
+		t.Fatalf("Fire returned error: %v", err)
+	}
+	if err := h.Fire(logrus.NewEntry(logrus.New())); err != nil {
+		t.Fatalf("Fire returned error: %v", err)
+	}
+
+	if got := h.Dropped(); got != 1 {
+		t.Fatalf("Dropped() = %d, want 1", got)
+	}
+	if len(h.entries) != 1 {
+		t.Fatalf("buffered entries = %d, want 1", len(h.entries))
+	}
+}
+
+// TestAsyncHookCloseDuringConcurrentFire guards against Close racing with a
+// concurrent Fire under OverflowBlock: Close used to close(h.entries)
+// directly, which could panic with "send on closed channel" if a Fire call
+// was blocked trying to send at the same moment.
+func TestAsyncHookCloseDuringConcurrentFire(t *testing.T) {
+	inner := &fakeHook{}
+	h := NewAsyncHook(inner, 1, time.Millisecond, OverflowBlock)
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = h.Fire(logrus.NewEntry(logrus.New()))
+			}
+		}
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	if err := h.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	close(stop)
+	wg.Wait()
+}
+
+func TestAsyncHookFireIsNoopAfterClose(t *testing.T) {
+	inner := &fakeHook{}
+	h := NewAsyncHook(inner, 1, time.Millisecond, OverflowBlock)
+
+	if err := h.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := h.Fire(logrus.NewEntry(logrus.New())); err != nil {
+		t.Fatalf("Fire after Close returned error: %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+// Package hooks provides ready-made logrus.Hook sinks (rotating file,
+// syslog/UDP, HTTP-batch) that reuse a logrus.Formatter (such as one built
+// by the logger package) for serialization,
+// plus AsyncHook to buffer any of them off the logging goroutine.
+package hooks
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FileHook writes formatted entries to a file, rotating it once it exceeds
+// maxSize bytes or maxAge, and gzipping the rotated segment.
+type FileHook struct {
+	formatter logrus.Formatter
+	path      string
+	maxSize   int64
+	maxAge    time.Duration
+
+	mu        sync.Mutex
+	file      *os.File
+	size      int64
+	openedAt  time.Time
+	rotations uint64
+}
+
+// NewFileHook opens (or creates) path for appending. maxSize <= 0 disables
+// size-based rotation; maxAge <= 0 disables time-based rotation.
+func NewFileHook(path string, formatter logrus.Formatter, maxSize int64, maxAge time.Duration) (*FileHook, error) {
+	h := &FileHook{path: path, formatter: formatter, maxSize: maxSize, maxAge: maxAge}
+	if err := h.open(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *FileHook) open() error {
+	file, err := os.OpenFile(h.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	h.file = file
+	h.size = info.Size()
+	h.openedAt = time.Now()
+	return nil
+}
+
+func (h *FileHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *FileHook) Fire(entry *logrus.Entry) error {
+	line, err := h.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.shouldRotate(int64(len(line))) {
+		if err := h.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := h.file.Write(line)
+	h.size += int64(n)
+	return err
+}
+
+func (h *FileHook) shouldRotate(nextLen int64) bool {
+	if h.maxSize > 0 && h.size+nextLen > h.maxSize {
+		return true
+	}
+	if h.maxAge > 0 && time.Since(h.openedAt) > h.maxAge {
+		return true
+	}
+	return false
+}
+
+// rotate closes and renames the current file aside for gzipping, then opens
+// a fresh one at path. The rotated name includes a monotonic per-hook
+// counter so rotations within the same wall-clock second can't collide and
+// silently overwrite each other.
+func (h *FileHook) rotate() error {
+	if err := h.file.Close(); err != nil {
+		return err
+	}
+
+	rotatedPath := fmt.Sprintf("%s.%s.%d", h.path, time.Now().Format("20060102T150405"), h.rotations)
+	h.rotations++
+	if err := os.Rename(h.path, rotatedPath); err != nil {
+		return err
+	}
+	go gzipAndRemove(rotatedPath)
+
+	return h.open()
+}
+
+// gzipAndRemove compresses path into path+".gz" and removes path. It writes
+// the gzip stream to a temp file in the same directory and only renames it
+// into place after the stream is fully flushed and closed, so a concurrent
+// reader never observes a partially-written .gz file.
+func gzipAndRemove(path string) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.gz.tmp")
+	if err != nil {
+		return
+	}
+	tmpPath := tmp.Name()
+
+	if err := writeGzip(tmp, path); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return
+	}
+
+	if err := os.Rename(tmpPath, path+".gz"); err != nil {
+		os.Remove(tmpPath)
+		return
+	}
+	os.Remove(path)
+}
+
+func writeGzip(dst io.Writer, srcPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	gz := gzip.NewWriter(dst)
+	if _, err := io.Copy(gz, src); err != nil {
+		gz.Close()
+		return err
+	}
+	return gz.Close()
+}
+
+// Close closes the underlying file.
+func (h *FileHook) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.file.Close()
+}
@@ -0,0 +1,124 @@
+package hooks
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// fixedFormatter returns line for every entry, so tests can control exactly
+// how many bytes each Fire call writes.
+type fixedFormatter struct {
+	line []byte
+}
+
+func (f fixedFormatter) Format(*logrus.Entry) ([]byte, error) {
+	return f.line, nil
+}
+
+func TestFileHookRotatesOnSizeAndGzips(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	line := []byte("0123456789\n")
+
+	hook, err := NewFileHook(path, fixedFormatter{line: line}, int64(len(line))+5, 0)
+	if err != nil {
+		t.Fatalf("NewFileHook: %v", err)
+	}
+	defer hook.Close()
+
+	entry := logrus.NewEntry(logrus.New())
+	for i := 0; i < 2; i++ {
+		if err := hook.Fire(entry); err != nil {
+			t.Fatalf("Fire: %v", err)
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if string(content) != string(line) {
+		t.Fatalf("current log file = %q, want %q", content, line)
+	}
+
+	var gzFiles []string
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		matches, _ := filepath.Glob(path + ".*.gz")
+		if len(matches) > 0 {
+			gzFiles = matches
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if len(gzFiles) != 1 {
+		t.Fatalf("expected one rotated gzip file, got %v", gzFiles)
+	}
+
+	gz, err := os.Open(gzFiles[0])
+	if err != nil {
+		t.Fatalf("Open rotated gzip: %v", err)
+	}
+	defer gz.Close()
+
+	r, err := gzip.NewReader(gz)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	defer r.Close()
+
+	rotated, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(rotated) != string(line) {
+		t.Fatalf("rotated gzip content = %q, want %q", rotated, line)
+	}
+}
+
+// TestFileHookRapidRotationsDontCollide guards against rotate() generating
+// the same rotated filename twice within the same wall-clock second, which
+// would make a later rotation silently overwrite an earlier one via
+// os.Rename.
+func TestFileHookRapidRotationsDontCollide(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	line := []byte("x\n")
+
+	// maxSize == len(line) forces a rotation on every Fire after the first.
+	hook, err := NewFileHook(path, fixedFormatter{line: line}, int64(len(line)), 0)
+	if err != nil {
+		t.Fatalf("NewFileHook: %v", err)
+	}
+	defer hook.Close()
+
+	const fires = 9 // 8 rotations: the 1st Fire doesn't trigger one
+	entry := logrus.NewEntry(logrus.New())
+	for i := 0; i < fires; i++ {
+		if err := hook.Fire(entry); err != nil {
+			t.Fatalf("Fire: %v", err)
+		}
+	}
+
+	const wantRotations = fires - 1
+	var gzFiles []string
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		matches, _ := filepath.Glob(path + ".*.gz")
+		if len(matches) >= wantRotations {
+			gzFiles = matches
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if len(gzFiles) != wantRotations {
+		t.Fatalf("got %d rotated gzip files, want %d (some rotations overwrote each other): %v",
+			len(gzFiles), wantRotations, gzFiles)
+	}
+}
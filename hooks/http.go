@@ -0,0 +1,114 @@
+package hooks
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const defaultHTTPHookRetries = 3
+
+// HTTPHook batches formatted entries and POSTs them as newline-delimited
+// JSON to endpoint, flushing whenever batchSize is reached or flushInterval
+// elapses, whichever comes first. Posts that fail with a transport error or
+// a 5xx status are retried with exponential backoff up to
+// defaultHTTPHookRetries times; any other status ends the attempt.
+type HTTPHook struct {
+	formatter  logrus.Formatter
+	endpoint   string
+	client     *http.Client
+	batchSize  int
+	flushEvery time.Duration
+
+	mu   sync.Mutex
+	buf  [][]byte
+	done chan struct{}
+}
+
+// NewHTTPHook starts a background goroutine that flushes buffered entries
+// to endpoint every flushEvery, or immediately once batchSize entries have
+// accumulated. Call Close to stop the goroutine and flush any remainder.
+func NewHTTPHook(endpoint string, formatter logrus.Formatter, batchSize int, flushEvery time.Duration) *HTTPHook {
+	h := &HTTPHook{
+		formatter:  formatter,
+		endpoint:   endpoint,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		batchSize:  batchSize,
+		flushEvery: flushEvery,
+		done:       make(chan struct{}),
+	}
+	go h.loop()
+	return h
+}
+
+func (h *HTTPHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *HTTPHook) Fire(entry *logrus.Entry) error {
+	line, err := h.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	h.buf = append(h.buf, line)
+	full := len(h.buf) >= h.batchSize
+	h.mu.Unlock()
+
+	if full {
+		h.flush()
+	}
+	return nil
+}
+
+func (h *HTTPHook) loop() {
+	ticker := time.NewTicker(h.flushEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.flush()
+		case <-h.done:
+			h.flush()
+			return
+		}
+	}
+}
+
+func (h *HTTPHook) flush() {
+	h.mu.Lock()
+	batch := h.buf
+	h.buf = nil
+	h.mu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+	body := bytes.Join(batch, nil)
+
+	for attempt := 0; attempt <= defaultHTTPHookRetries; attempt++ {
+		resp, err := h.client.Post(h.endpoint, "application/x-ndjson", bytes.NewReader(body))
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode < 500 {
+				return
+			}
+		}
+		time.Sleep(backoff(attempt))
+	}
+}
+
+func backoff(attempt int) time.Duration {
+	return (1 << uint(attempt)) * 100 * time.Millisecond
+}
+
+// Close stops the flush loop and sends any buffered entries.
+func (h *HTTPHook) Close() error {
+	close(h.done)
+	return nil
+}
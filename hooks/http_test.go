@@ -0,0 +1,83 @@
+package hooks
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type countingHandler struct {
+	mu       sync.Mutex
+	requests int
+	failN    int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_, _ = io.ReadAll(r.Body)
+
+	h.mu.Lock()
+	h.requests++
+	n := h.requests
+	h.mu.Unlock()
+
+	if n <= h.failN {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *countingHandler) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.requests
+}
+
+func TestHTTPHookFlushesOnBatchSize(t *testing.T) {
+	handler := &countingHandler{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	hook := NewHTTPHook(server.URL, fixedFormatter{line: []byte("hello\n")}, 2, time.Hour)
+	defer hook.Close()
+
+	if err := hook.Fire(logrus.NewEntry(logrus.New())); err != nil {
+		t.Fatalf("Fire: %v", err)
+	}
+	if err := hook.Fire(logrus.NewEntry(logrus.New())); err != nil {
+		t.Fatalf("Fire: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if handler.count() == 1 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("server received %d requests, want 1", handler.count())
+}
+
+func TestHTTPHookRetriesOnServerError(t *testing.T) {
+	handler := &countingHandler{failN: 2}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	hook := NewHTTPHook(server.URL, fixedFormatter{line: []byte("hello\n")}, 1, time.Hour)
+	defer hook.Close()
+
+	// batchSize is 1, so Fire flushes synchronously and retries (with
+	// backoff) until the 3rd request finally succeeds.
+	if err := hook.Fire(logrus.NewEntry(logrus.New())); err != nil {
+		t.Fatalf("Fire: %v", err)
+	}
+
+	if got := handler.count(); got != 3 {
+		t.Fatalf("server received %d requests, want 3", got)
+	}
+}
@@ -0,0 +1,49 @@
+package hooks
+
+import (
+	"net"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SyslogHook writes formatted entries to a syslog-style connection, e.g.
+// "udp" to a local syslog daemon.
+type SyslogHook struct {
+	formatter logrus.Formatter
+	mu        sync.Mutex
+	conn      net.Conn
+}
+
+// NewSyslogHook dials network/address (e.g. "udp", "localhost:514") and
+// writes every formatted entry to the resulting connection.
+func NewSyslogHook(network, address string, formatter logrus.Formatter) (*SyslogHook, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogHook{formatter: formatter, conn: conn}, nil
+}
+
+func (h *SyslogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *SyslogHook) Fire(entry *logrus.Entry) error {
+	line, err := h.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err = h.conn.Write(line)
+	return err
+}
+
+// Close closes the underlying connection.
+func (h *SyslogHook) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.conn.Close()
+}
@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const logstashTimeFormat = time.RFC3339Nano
+
+// SetLogstashOutput switches the formatter to a Logstash-compatible JSON
+// mode (@timestamp, @version, type, message, level, with entry.Data fields
+// flattened at the top level), so log lines can be shipped straight into a
+// Filebeat/Logstash/Elasticsearch pipeline without a separate transformer.
+func (f *customFormatter) SetLogstashOutput(typeName string) *customFormatter {
+	f.logstashOutput = true
+	f.logstashType = typeName
+	return f
+}
+
+// SetECSOutput switches the formatter to Elastic Common Schema field names
+// (log.level, @timestamp, message, service.name), with entry.Data fields
+// flattened at the top level.
+func (f *customFormatter) SetECSOutput(serviceName string) *customFormatter {
+	f.ecsOutput = true
+	f.ecsServiceName = serviceName
+	return f
+}
+
+// flattenData copies entry.Data into data, promoting RequestIdKey to
+// "request_id" to match the Logstash/ECS convention.
+func flattenData(data logrus.Fields, entryData logrus.Fields) {
+	for k, val := range entryData {
+		if k == RequestIdKey {
+			data["request_id"] = val
+			continue
+		}
+		data[k] = val
+	}
+}
+
+func (f *customFormatter) logstashFormat(entry *logrus.Entry) ([]byte, error) {
+	data := make(logrus.Fields, 5+len(entry.Data))
+	data["@timestamp"] = entry.Time.UTC().Format(logstashTimeFormat)
+	data["@version"] = "1"
+	data["type"] = f.logstashType
+	data["message"] = entry.Message
+	data["level"] = strings.ToUpper(entry.Level.String())
+	if f.reportCaller {
+		data["caller"], data["func"] = f.callerInfo()
+	}
+	flattenData(data, entry.Data)
+
+	output, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return append(output, '\n'), nil
+}
+
+func (f *customFormatter) ecsFormat(entry *logrus.Entry) ([]byte, error) {
+	data := make(logrus.Fields, 5+len(entry.Data))
+	data["@timestamp"] = entry.Time.UTC().Format(logstashTimeFormat)
+	data["log.level"] = strings.ToLower(entry.Level.String())
+	data["message"] = entry.Message
+	data["service.name"] = f.ecsServiceName
+	if f.reportCaller {
+		data["caller"], data["func"] = f.callerInfo()
+	}
+	flattenData(data, entry.Data)
+
+	output, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return append(output, '\n'), nil
+}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestLogstashFormatFields(t *testing.T) {
+	f := NewFormatter().SetLogstashOutput("myapp")
+	entry := newTestEntry("hello", logrus.Fields{"foo": "bar", RequestIdKey: "req-1"})
+
+	out, err := f.logstashFormat(entry)
+	if err != nil {
+		t.Fatalf("logstashFormat: %v", err)
+	}
+	decoded := decodeJSON(t, out)
+
+	if decoded["@version"] != "1" {
+		t.Fatalf("@version = %v, want 1", decoded["@version"])
+	}
+	if decoded["type"] != "myapp" {
+		t.Fatalf("type = %v, want myapp", decoded["type"])
+	}
+	if decoded["message"] != "hello" {
+		t.Fatalf("message = %v, want raw entry.Message %q", decoded["message"], "hello")
+	}
+	if decoded["level"] != "INFO" {
+		t.Fatalf("level = %v, want INFO", decoded["level"])
+	}
+	if decoded["foo"] != "bar" {
+		t.Fatalf("foo = %v, want bar (entry.Data should be flattened)", decoded["foo"])
+	}
+	if decoded["request_id"] != "req-1" {
+		t.Fatalf("request_id = %v, want req-1 (RequestIdKey should be promoted)", decoded["request_id"])
+	}
+	if _, exist := decoded[RequestIdKey]; exist {
+		t.Fatalf("raw %q key should not be present, got %#v", RequestIdKey, decoded)
+	}
+}
+
+func TestECSFormatFields(t *testing.T) {
+	f := NewFormatter().SetECSOutput("myapp")
+	entry := newTestEntry("hello", logrus.Fields{"foo": "bar"})
+
+	out, err := f.ecsFormat(entry)
+	if err != nil {
+		t.Fatalf("ecsFormat: %v", err)
+	}
+	decoded := decodeJSON(t, out)
+
+	if decoded["log.level"] != "info" {
+		t.Fatalf("log.level = %v, want info", decoded["log.level"])
+	}
+	if decoded["message"] != "hello" {
+		t.Fatalf("message = %v, want hello", decoded["message"])
+	}
+	if decoded["service.name"] != "myapp" {
+		t.Fatalf("service.name = %v, want myapp", decoded["service.name"])
+	}
+	if decoded["foo"] != "bar" {
+		t.Fatalf("foo = %v, want bar (entry.Data should be flattened)", decoded["foo"])
+	}
+}
+
+func TestLogstashFormatReportsCaller(t *testing.T) {
+	f := NewFormatter().SetLogstashOutput("myapp").SetReportCaller(true)
+	out, err := f.logstashFormat(newTestEntry("hello", nil))
+	if err != nil {
+		t.Fatalf("logstashFormat: %v", err)
+	}
+	decoded := decodeJSON(t, out)
+
+	if _, exist := decoded["caller"]; !exist {
+		t.Fatalf("expected caller field when reportCaller is enabled, got %#v", decoded)
+	}
+	if _, exist := decoded["func"]; !exist {
+		t.Fatalf("expected func field when reportCaller is enabled, got %#v", decoded)
+	}
+}
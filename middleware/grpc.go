@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/jialijelly/go-custom-logger"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadataRequestIDKey is the gRPC metadata key counterpart of
+// logger.RequestIdKey; gRPC metadata keys are lowercased by the runtime.
+const metadataRequestIDKey = "x-request-id"
+
+func requestIDFromIncomingContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(metadataRequestIDKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return generateRequestID()
+}
+
+// UnaryServerInterceptor logs an incoming (">>>") and outgoing ("<<<") line
+// around each unary RPC, tagging every line with an X-Request-ID read from
+// incoming metadata or generated if absent. A panic in handler is recovered,
+// logged with PrefixRequestHandling, and turned into a codes.Internal error.
+// Handlers can retrieve the request-scoped entry via logger.FromContext or
+// WithRequestID.
+func UnaryServerInterceptor(log *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		requestID := requestIDFromIncomingContext(ctx)
+		entry := log.WithField(logger.RequestIdKey, requestID)
+		ctx = logger.NewContext(ctx, entry)
+
+		entry.Infof("%s %s", logger.PrefixRequestIncoming, info.FullMethod)
+
+		start := time.Now()
+		defer func() {
+			if rec := recover(); rec != nil {
+				entry.Errorf("%s panic: %v", logger.PrefixRequestHandling, rec)
+				err = status.Errorf(codes.Internal, "panic: %v", rec)
+			}
+			entry.WithError(err).Infof("%s %s in %s", logger.PrefixRequestOutgoing, info.FullMethod, time.Since(start))
+		}()
+
+		resp, err = handler(ctx, req)
+		return resp, err
+	}
+}
+
+// wrappedServerStream overrides Context so handler code sees the
+// request-scoped logger entry via logger.FromContext.
+type wrappedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *wrappedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor, including the same panic recovery.
+func StreamServerInterceptor(log *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		requestID := requestIDFromIncomingContext(ss.Context())
+		entry := log.WithField(logger.RequestIdKey, requestID)
+		ctx := logger.NewContext(ss.Context(), entry)
+
+		entry.Infof("%s %s", logger.PrefixRequestIncoming, info.FullMethod)
+
+		start := time.Now()
+		defer func() {
+			if rec := recover(); rec != nil {
+				entry.Errorf("%s panic: %v", logger.PrefixRequestHandling, rec)
+				err = status.Errorf(codes.Internal, "panic: %v", rec)
+			}
+			entry.WithError(err).Infof("%s %s in %s", logger.PrefixRequestOutgoing, info.FullMethod, time.Since(start))
+		}()
+
+		err = handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+		return err
+	}
+}
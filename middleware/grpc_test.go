@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	logger "github.com/jialijelly/go-custom-logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestUnaryServerInterceptorPropagatesRequestID(t *testing.T) {
+	var gotID string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		gotID, _ = WithRequestID(ctx).Data[logger.RequestIdKey].(string)
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(metadataRequestIDKey, "xyz-789"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	if _, err := UnaryServerInterceptor(newSilentLogger())(ctx, nil, info, handler); err != nil {
+		t.Fatalf("interceptor returned error: %v", err)
+	}
+	if gotID != "xyz-789" {
+		t.Fatalf("request id = %q, want %q", gotID, "xyz-789")
+	}
+}
+
+func TestUnaryServerInterceptorGeneratesRequestID(t *testing.T) {
+	var gotID string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		gotID, _ = WithRequestID(ctx).Data[logger.RequestIdKey].(string)
+		return nil, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	if _, err := UnaryServerInterceptor(newSilentLogger())(context.Background(), nil, info, handler); err != nil {
+		t.Fatalf("interceptor returned error: %v", err)
+	}
+	if gotID == "" {
+		t.Fatal("expected a generated request id")
+	}
+}
+
+func TestUnaryServerInterceptorRecoversFromPanic(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	resp, err := UnaryServerInterceptor(newSilentLogger())(context.Background(), nil, info, handler)
+	if resp != nil {
+		t.Fatalf("resp = %v, want nil", resp)
+	}
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("err code = %v, want %v", status.Code(err), codes.Internal)
+	}
+}
+
+func TestStreamServerInterceptorRecoversFromPanic(t *testing.T) {
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		panic("boom")
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Method"}
+	ss := &fakeServerStream{ctx: context.Background()}
+
+	err := StreamServerInterceptor(newSilentLogger())(nil, ss, info, handler)
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("err code = %v, want %v", status.Code(err), codes.Internal)
+	}
+}
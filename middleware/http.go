@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jialijelly/go-custom-logger"
+	"github.com/sirupsen/logrus"
+)
+
+// statusWriter captures the status code written by the wrapped handler so
+// it can be included in the outgoing log line.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// HTTP returns net/http middleware that reads or generates an
+// X-Request-ID, logs an incoming (">>>") and outgoing ("<<<") line around
+// the wrapped handler, and recovers panics with a handling ("===") log
+// line. Downstream handlers can retrieve the request-scoped entry via
+// logger.FromContext or WithRequestID.
+func HTTP(log *logrus.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(logger.RequestIdKey)
+			if requestID == "" {
+				requestID = generateRequestID()
+			}
+			w.Header().Set(logger.RequestIdKey, requestID)
+
+			entry := log.WithField(logger.RequestIdKey, requestID)
+			r = r.WithContext(logger.NewContext(r.Context(), entry))
+
+			entry.Infof("%s %s %s from %s", logger.PrefixRequestIncoming, r.Method, r.URL.Path, r.RemoteAddr)
+
+			start := time.Now()
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+
+			defer func() {
+				if rec := recover(); rec != nil {
+					entry.Errorf("%s panic: %v", logger.PrefixRequestHandling, rec)
+					sw.WriteHeader(http.StatusInternalServerError)
+				}
+				entry.WithField("status", sw.status).Infof(
+					"%s %s %s in %s", logger.PrefixRequestOutgoing, r.Method, r.URL.Path, time.Since(start))
+			}()
+
+			next.ServeHTTP(sw, r)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	logger "github.com/jialijelly/go-custom-logger"
+	"github.com/sirupsen/logrus"
+)
+
+func newSilentLogger() *logrus.Logger {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	return log
+}
+
+func TestHTTPPropagatesRequestID(t *testing.T) {
+	var gotID string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotID, _ = WithRequestID(r.Context()).Data[logger.RequestIdKey].(string)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(logger.RequestIdKey, "abc-123")
+	rec := httptest.NewRecorder()
+
+	HTTP(newSilentLogger())(next).ServeHTTP(rec, req)
+
+	if gotID != "abc-123" {
+		t.Fatalf("request id in context = %q, want %q", gotID, "abc-123")
+	}
+	if got := rec.Header().Get(logger.RequestIdKey); got != "abc-123" {
+		t.Fatalf("response header %s = %q, want %q", logger.RequestIdKey, got, "abc-123")
+	}
+}
+
+func TestHTTPGeneratesRequestIDWhenMissing(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+
+	HTTP(newSilentLogger())(next).ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(logger.RequestIdKey); got == "" {
+		t.Fatal("expected a generated X-Request-ID response header")
+	}
+}
+
+func TestHTTPRecoversFromPanic(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+
+	HTTP(newSilentLogger())(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
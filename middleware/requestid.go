@@ -0,0 +1,30 @@
+// Package middleware provides HTTP and gRPC request logging built on top of
+// the logger package's PrefixRequestIncoming/Handling/Outgoing convention
+// and its RequestIdKey / X-Request-ID mechanism.
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/jialijelly/go-custom-logger"
+	"github.com/sirupsen/logrus"
+)
+
+// generateRequestID returns a random 16-byte hex request id, used when a
+// caller doesn't supply its own X-Request-ID.
+func generateRequestID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
+
+// WithRequestID returns the request-scoped *logrus.Entry (already tagged
+// with logger.RequestIdKey) stashed on ctx by this package's middleware, so
+// handler code gets the right request id injected into every log line.
+func WithRequestID(ctx context.Context) *logrus.Entry {
+	return logger.FromContext(ctx).Entry
+}
@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"io"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Option configures a *logrus.Logger built by New.
+type Option func(*logrus.Logger)
+
+// WithFormatter sets the logger's formatter, e.g. DefaultFormatter,
+// NewFormatter, or one built via GetFormatter.
+func WithFormatter(formatter logrus.Formatter) Option {
+	return func(log *logrus.Logger) {
+		log.SetFormatter(formatter)
+	}
+}
+
+// WithLevel sets the logger's minimum level.
+func WithLevel(level logrus.Level) Option {
+	return func(log *logrus.Logger) {
+		log.SetLevel(level)
+	}
+}
+
+// WithOutput sets the writer log lines are written to.
+func WithOutput(out io.Writer) Option {
+	return func(log *logrus.Logger) {
+		log.SetOutput(out)
+	}
+}
+
+// WithHook registers one or more additional sinks (see the logger/hooks
+// subpackage) that every log entry is also sent to.
+func WithHook(hooks ...logrus.Hook) Option {
+	return func(log *logrus.Logger) {
+		for _, hook := range hooks {
+			log.AddHook(hook)
+		}
+	}
+}
+
+// New builds a *logrus.Logger using DefaultFormatter("") unless overridden,
+// so callers can wire up formatter, level and hooks without touching logrus
+// directly.
+func New(opts ...Option) *logrus.Logger {
+	log := logrus.New()
+	log.SetFormatter(DefaultFormatter(""))
+
+	for _, opt := range opts {
+		opt(log)
+	}
+	return log
+}
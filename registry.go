@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FormatterFactory builds a logrus.Formatter from a set of string options,
+// e.g. as decoded from a JSON/YAML config file.
+type FormatterFactory func(opts map[string]string) (logrus.Formatter, error)
+
+var (
+	formatterRegistryMu sync.RWMutex
+	formatterRegistry   = make(map[string]FormatterFactory)
+)
+
+func init() {
+	RegisterFormatter("default", func(opts map[string]string) (logrus.Formatter, error) {
+		return DefaultFormatter(opts["prefix"]), nil
+	})
+
+	RegisterFormatter("custom", func(opts map[string]string) (logrus.Formatter, error) {
+		f := NewFormatter()
+		if v, ok := opts["prefix"]; ok {
+			f.SetLogPrefix(v)
+		}
+		if v, ok := opts["format"]; ok {
+			f.SetLogFormat(v)
+		}
+		if v, ok := opts["timeFormat"]; ok {
+			f.SetTimeFormat(v)
+		}
+		if v, ok := opts["dataSeparator"]; ok {
+			f.SetDataSeparator(v)
+		}
+		if v, ok := opts["jsonOutput"]; ok && v == "true" {
+			f.SetJsonOutput()
+		}
+		if v, ok := opts["terminalOutput"]; ok && v == "true" {
+			f.SetTerminalOutput()
+		}
+		if v, ok := opts["reportCaller"]; ok && v == "true" {
+			f.SetReportCaller(true)
+		}
+		return f, nil
+	})
+
+	RegisterFormatter("logstash", func(opts map[string]string) (logrus.Formatter, error) {
+		f := DefaultFormatter("").SetLogstashOutput(opts["type"])
+		if v, ok := opts["reportCaller"]; ok && v == "true" {
+			f.SetReportCaller(true)
+		}
+		return f, nil
+	})
+
+	RegisterFormatter("ecs", func(opts map[string]string) (logrus.Formatter, error) {
+		f := DefaultFormatter("").SetECSOutput(opts["serviceName"])
+		if v, ok := opts["reportCaller"]; ok && v == "true" {
+			f.SetReportCaller(true)
+		}
+		return f, nil
+	})
+}
+
+// RegisterFormatter makes a named formatter available to GetFormatter.
+// Registering under a name that's already in use replaces the previous
+// factory, so downstream services can swap out a built-in ("default",
+// "custom", "logstash", "ecs") or add their own (e.g. "gelf").
+func RegisterFormatter(name string, factory FormatterFactory) {
+	formatterRegistryMu.Lock()
+	defer formatterRegistryMu.Unlock()
+	formatterRegistry[name] = factory
+}
+
+// GetFormatter looks up a formatter by name and builds it from opts, so
+// callers can pick a formatter from JSON/YAML config at boot time without
+// hard-coding a type.
+func GetFormatter(name string, opts map[string]string) (logrus.Formatter, error) {
+	formatterRegistryMu.RLock()
+	factory, exist := formatterRegistry[name]
+	formatterRegistryMu.RUnlock()
+
+	if !exist {
+		return nil, fmt.Errorf("logger: formatter %q is not registered", name)
+	}
+	return factory(opts)
+}
@@ -0,0 +1,104 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestGetFormatterUnknownNameErrors(t *testing.T) {
+	if _, err := GetFormatter("does-not-exist", nil); err == nil {
+		t.Fatal("expected error for unregistered formatter name, got nil")
+	}
+}
+
+func TestGetFormatterDefaultBuildsDefaultFormatter(t *testing.T) {
+	f, err := GetFormatter("default", map[string]string{"prefix": "app: "})
+	if err != nil {
+		t.Fatalf("GetFormatter: %v", err)
+	}
+	cf, ok := f.(*customFormatter)
+	if !ok {
+		t.Fatalf("got %T, want *customFormatter", f)
+	}
+	if !cf.isDefault {
+		t.Fatal("expected isDefault formatter")
+	}
+	if cf.MsgPrefix != "app: " {
+		t.Fatalf("MsgPrefix = %q, want %q", cf.MsgPrefix, "app: ")
+	}
+}
+
+func TestGetFormatterCustomAppliesOptions(t *testing.T) {
+	f, err := GetFormatter("custom", map[string]string{
+		"format":     "<msg>",
+		"jsonOutput": "true",
+	})
+	if err != nil {
+		t.Fatalf("GetFormatter: %v", err)
+	}
+	cf, ok := f.(*customFormatter)
+	if !ok {
+		t.Fatalf("got %T, want *customFormatter", f)
+	}
+	if cf.MsgFormat != "<msg>" {
+		t.Fatalf("MsgFormat = %q, want %q", cf.MsgFormat, "<msg>")
+	}
+	if !cf.jsonOutput {
+		t.Fatal("expected jsonOutput to be enabled")
+	}
+}
+
+func TestGetFormatterLogstashAndECS(t *testing.T) {
+	logstash, err := GetFormatter("logstash", map[string]string{"type": "myapp"})
+	if err != nil {
+		t.Fatalf("GetFormatter(logstash): %v", err)
+	}
+	if cf := logstash.(*customFormatter); !cf.logstashOutput || cf.logstashType != "myapp" {
+		t.Fatalf("logstash formatter misconfigured: %+v", cf)
+	}
+
+	ecs, err := GetFormatter("ecs", map[string]string{"serviceName": "myapp"})
+	if err != nil {
+		t.Fatalf("GetFormatter(ecs): %v", err)
+	}
+	if cf := ecs.(*customFormatter); !cf.ecsOutput || cf.ecsServiceName != "myapp" {
+		t.Fatalf("ecs formatter misconfigured: %+v", cf)
+	}
+}
+
+// TestRegisterFormatterOverridesExisting proves RegisterFormatter replaces
+// a previously registered factory under the same name, e.g. to swap out a
+// built-in like "default" for an application-specific one.
+func TestRegisterFormatterOverridesExisting(t *testing.T) {
+	const name = "test-override"
+	calls := 0
+	RegisterFormatter(name, func(opts map[string]string) (logrus.Formatter, error) {
+		calls++
+		return DefaultFormatter(opts["prefix"]), nil
+	})
+
+	if _, err := GetFormatter(name, map[string]string{"prefix": "v1: "}); err != nil {
+		t.Fatalf("GetFormatter: %v", err)
+	}
+
+	RegisterFormatter(name, func(opts map[string]string) (logrus.Formatter, error) {
+		calls++
+		return NewFormatter(), nil
+	})
+
+	f, err := GetFormatter(name, nil)
+	if err != nil {
+		t.Fatalf("GetFormatter: %v", err)
+	}
+	cf, ok := f.(*customFormatter)
+	if !ok {
+		t.Fatalf("got %T, want *customFormatter", f)
+	}
+	if cf.isDefault {
+		t.Fatal("got the first factory's DefaultFormatter, want the replacement's NewFormatter")
+	}
+	if calls != 2 {
+		t.Fatalf("factory called %d times, want 2", calls)
+	}
+}
@@ -0,0 +1,119 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/term"
+)
+
+const (
+	colorReset   = "\033[0m"
+	colorCyan    = "\033[36m"
+	colorGreen   = "\033[32m"
+	colorYellow  = "\033[33m"
+	colorRed     = "\033[31m"
+	colorMagenta = "\033[35m"
+)
+
+// levelColor returns the ANSI color code used for level in terminal output.
+func levelColor(level logrus.Level) string {
+	switch level {
+	case logrus.DebugLevel, logrus.TraceLevel:
+		return colorCyan
+	case logrus.InfoLevel:
+		return colorGreen
+	case logrus.WarnLevel:
+		return colorYellow
+	case logrus.ErrorLevel:
+		return colorRed
+	case logrus.FatalLevel, logrus.PanicLevel:
+		return colorMagenta
+	default:
+		return ""
+	}
+}
+
+// SetTerminalOutput switches the formatter to a terminal-oriented mode:
+// level-colored output with key=value data pairs padded to line up across
+// log lines. Color is autodetected from the logger's output unless
+// overridden with SetColor.
+func (f *customFormatter) SetTerminalOutput() *customFormatter {
+	f.terminalOutput = true
+	return f
+}
+
+// SetColor forces color on or off for terminal output, overriding TTY
+// autodetection.
+func (f *customFormatter) SetColor(enabled bool) *customFormatter {
+	f.colorOutput = &enabled
+	return f
+}
+
+// isColorEnabled reports whether ANSI colors should be emitted, honoring an
+// explicit SetColor override and otherwise autodetecting whether the
+// logger's output is a TTY.
+func (f *customFormatter) isColorEnabled(entry *logrus.Entry) bool {
+	if f.colorOutput != nil {
+		return *f.colorOutput
+	}
+	if entry.Logger == nil {
+		return false
+	}
+	file, ok := entry.Logger.Out.(*os.File)
+	if !ok {
+		return false
+	}
+	return term.IsTerminal(int(file.Fd()))
+}
+
+// fieldWidth tracks and returns the widest value seen so far for a data key,
+// so subsequent values for that key can be right-padded to line up.
+func (f *customFormatter) fieldWidth(key string, value string) int {
+	f.fieldWidthsMu.Lock()
+	defer f.fieldWidthsMu.Unlock()
+
+	if f.fieldWidths == nil {
+		f.fieldWidths = make(map[string]int)
+	}
+	if width := len(value); width > f.fieldWidths[key] {
+		f.fieldWidths[key] = width
+	}
+	return f.fieldWidths[key]
+}
+
+// terminalFormat builds on the same time/level/id/caller/msg/data-token
+// substitution as getMessage (shared with textFormat and jsonFormat) so
+// terminal output stays consistent with the other modes, then colorizes
+// the level and pads entry.Data key=value pairs to line up across lines.
+func (f *customFormatter) terminalFormat(entry *logrus.Entry) ([]byte, error) {
+	output := f.getMessage(entry)
+
+	if f.isColorEnabled(entry) {
+		plainLevel := fmt.Sprintf("%5s", strings.ToUpper(entry.Level.String()))
+		output = strings.Replace(output, plainLevel, levelColor(entry.Level)+plainLevel+colorReset, 1)
+	}
+
+	keys := make([]string, 0, len(entry.Data))
+	for k := range entry.Data {
+		if k == RequestIdKey {
+			continue
+		}
+		if strings.Contains(output, FormatIdentifier(k)) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value := fmt.Sprintf("%v", entry.Data[k])
+		width := f.fieldWidth(k, value)
+		output = fmt.Sprintf("%v%s %s = %-*s", output, f.textDataSeparator, k, width, value)
+	}
+
+	return []byte(fmt.Sprintln(output)), nil
+}
@@ -0,0 +1,76 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestTerminalFormatColorsLevelWhenEnabled(t *testing.T) {
+	f := DefaultFormatter("").SetTerminalOutput().SetColor(true)
+	out, err := f.Format(newTestEntry("hello", nil))
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	if !strings.Contains(string(out), colorGreen) || !strings.Contains(string(out), colorReset) {
+		t.Fatalf("expected colored INFO level, got %q", out)
+	}
+}
+
+func TestTerminalFormatNoColorWhenDisabled(t *testing.T) {
+	f := DefaultFormatter("").SetTerminalOutput().SetColor(false)
+	out, err := f.Format(newTestEntry("hello", nil))
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	if strings.Contains(string(out), colorGreen) || strings.Contains(string(out), colorReset) {
+		t.Fatalf("expected no ANSI color codes, got %q", out)
+	}
+}
+
+func TestTerminalFormatPadsDataKeysAcrossLines(t *testing.T) {
+	f := DefaultFormatter("").SetTerminalOutput().SetColor(false)
+
+	// Fire the widest value first so the second (shorter) value gets padded
+	// out to match it, proving the width tracking persists across calls.
+	out1, err := f.Format(newTestEntry("hello", logrus.Fields{"foo": "longer"}))
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	out2, err := f.Format(newTestEntry("hello", logrus.Fields{"foo": "a"}))
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	if !strings.Contains(string(out1), "foo = longer") {
+		t.Fatalf("first line wrong, got %q", out1)
+	}
+	if !strings.Contains(string(out2), "foo = a     ") {
+		t.Fatalf("second line not padded to widest value seen, got %q", out2)
+	}
+}
+
+// TestTerminalFormatSubstitutesDataTokens guards against terminalFormat
+// re-implementing only part of getMessage's substitution: a MsgFormat
+// referencing a data key as "<foo>" must have that token replaced, matching
+// textFormat's behavior for the same MsgFormat (including textFormat's
+// pre-existing quirk of also appending the key=value pair, since its
+// already-consumed check runs against getMessage's substituted output
+// rather than the original MsgFormat).
+func TestTerminalFormatSubstitutesDataTokens(t *testing.T) {
+	f := NewFormatter().SetLogFormat("<msg>: <foo>").SetTerminalOutput().SetColor(false)
+	out, err := f.Format(newTestEntry("hello", logrus.Fields{"foo": "bar"}))
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	if strings.Contains(string(out), "<foo>") {
+		t.Fatalf("expected <foo> token to be substituted, got %q", out)
+	}
+	if !strings.Contains(string(out), "hello: bar") {
+		t.Fatalf("expected substituted token in message, got %q", out)
+	}
+}